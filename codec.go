@@ -0,0 +1,115 @@
+package shapes
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// envelope is the on-the-wire representation of a Shape: a type tag
+// plus its encoded fields.
+type envelope struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+// registry maps a type tag to a factory returning a fresh *T for that
+// shape, so Unmarshal can decode JSON into the right concrete type.
+// tagOf is the inverse mapping, from concrete type to tag, so Marshal
+// can find the tag for a given value.
+var (
+	registry = map[string]func() Shape{}
+	tagOf    = map[reflect.Type]string{}
+)
+
+func init() {
+	Register("circle", func() Shape { return &Circle{} })
+	Register("triangle", func() Shape { return &Triangle{} })
+	Register("rectangle", func() Shape { return &Rectangle{} })
+	Register("square", func() Shape { return &Square{} })
+	Register("ellipse", func() Shape { return &Ellipse{} })
+	Register("regularPolygon", func() Shape { return &RegularPolygon{} })
+	Register("trapezoid", func() Shape { return &Trapezoid{} })
+}
+
+// Register adds a type tag and factory to the registry so downstream
+// packages can plug in their own Shape implementations for Marshal and
+// Unmarshal. factory must return a pointer to the shape's underlying
+// type (e.g. func() Shape { return &MyShape{} }). It panics if name is
+// already registered.
+func Register(name string, factory func() Shape) {
+	if _, exists := registry[name]; exists {
+		panic(fmt.Sprintf("shapes: Register: %q is already registered", name))
+	}
+	registry[name] = factory
+	ptrType := reflect.TypeOf(factory())
+	// Register both T and *T so Marshal finds the tag regardless of
+	// whether the shape's methods use value or pointer receivers.
+	tagOf[ptrType] = name
+	tagOf[ptrType.Elem()] = name
+}
+
+// Marshal encodes a Shape as a {"type":"...","data":{...}} envelope
+// that Unmarshal can decode back into the same concrete type.
+func Marshal(s Shape) ([]byte, error) {
+	name, ok := tagOf[reflect.TypeOf(s)]
+	if !ok {
+		return nil, fmt.Errorf("shapes: Marshal: unregistered shape type %T", s)
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		return nil, fmt.Errorf("shapes: Marshal: %w", err)
+	}
+	return json.Marshal(envelope{Type: name, Data: data})
+}
+
+// Unmarshal decodes a {"type":"...","data":{...}} envelope produced by
+// Marshal back into a Shape of the registered concrete type.
+func Unmarshal(b []byte) (Shape, error) {
+	var env envelope
+	if err := json.Unmarshal(b, &env); err != nil {
+		return nil, fmt.Errorf("shapes: Unmarshal: %w", err)
+	}
+	factory, ok := registry[env.Type]
+	if !ok {
+		return nil, fmt.Errorf("shapes: Unmarshal: unknown type tag %q", env.Type)
+	}
+	ptr := factory()
+	if err := json.Unmarshal(env.Data, ptr); err != nil {
+		return nil, fmt.Errorf("shapes: Unmarshal: %w", err)
+	}
+	return reflect.ValueOf(ptr).Elem().Interface().(Shape), nil
+}
+
+// ShapeList is a slice of Shape that marshals to and from a JSON array
+// of envelopes, preserving each element's concrete type.
+type ShapeList []Shape
+
+func (l ShapeList) MarshalJSON() ([]byte, error) {
+	envs := make([]json.RawMessage, len(l))
+	for i, s := range l {
+		b, err := Marshal(s)
+		if err != nil {
+			return nil, fmt.Errorf("shapes: ShapeList.MarshalJSON: index %d: %w", i, err)
+		}
+		envs[i] = b
+	}
+	return json.Marshal(envs)
+}
+
+func (l *ShapeList) UnmarshalJSON(b []byte) error {
+	var raws []json.RawMessage
+	if err := json.Unmarshal(b, &raws); err != nil {
+		return fmt.Errorf("shapes: ShapeList.UnmarshalJSON: %w", err)
+	}
+	out := make(ShapeList, len(raws))
+	for i, raw := range raws {
+		s, err := Unmarshal(raw)
+		if err != nil {
+			return fmt.Errorf("shapes: ShapeList.UnmarshalJSON: index %d: %w", i, err)
+		}
+		out[i] = s
+	}
+	*l = out
+	return nil
+}
@@ -0,0 +1,70 @@
+package shapes
+
+import (
+	"testing"
+)
+
+func TestMarshalUnmarshalRoundTrip(t *testing.T) {
+	cases := []struct {
+		name  string
+		shape Shape
+	}{
+		{"circle", Circle{Radius: 5}},
+		{"triangle", Triangle{A: 3, B: 4, C: 5}},
+		{"rectangle", Rectangle{Width: 6, Height: 4}},
+		{"square", Square{Side: 3}},
+		{"ellipse", Ellipse{A: 3, B: 2}},
+		{"regularPolygon", RegularPolygon{Sides: 6, SideLength: 2}},
+		{"trapezoid", Trapezoid{A: 6, B: 4, Height: 3, LegL: 2.5, LegR: 2.5}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			b, err := Marshal(tc.shape)
+			if err != nil {
+				t.Fatalf("Marshal() unexpected error: %v", err)
+			}
+			got, err := Unmarshal(b)
+			if err != nil {
+				t.Fatalf("Unmarshal() unexpected error: %v", err)
+			}
+			if got != tc.shape {
+				t.Errorf("round trip = %+v, want %+v", got, tc.shape)
+			}
+		})
+	}
+}
+
+func TestUnmarshalUnknownType(t *testing.T) {
+	_, err := Unmarshal([]byte(`{"type":"hexagon","data":{}}`))
+	if err == nil {
+		t.Fatal("Unmarshal() with unknown type tag: expected error, got nil")
+	}
+}
+
+func TestShapeListRoundTrip(t *testing.T) {
+	list := ShapeList{
+		Circle{Radius: 1},
+		Rectangle{Width: 2, Height: 3},
+		Square{Side: 4},
+	}
+
+	b, err := list.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() unexpected error: %v", err)
+	}
+
+	var got ShapeList
+	if err := got.UnmarshalJSON(b); err != nil {
+		t.Fatalf("UnmarshalJSON() unexpected error: %v", err)
+	}
+
+	if len(got) != len(list) {
+		t.Fatalf("len(got) = %d, want %d", len(got), len(list))
+	}
+	for i := range list {
+		if got[i] != list[i] {
+			t.Errorf("index %d = %+v, want %+v", i, got[i], list[i])
+		}
+	}
+}
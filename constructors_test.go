@@ -0,0 +1,97 @@
+package shapes
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewCircle(t *testing.T) {
+	cases := []struct {
+		name    string
+		radius  float64
+		wantErr error
+	}{
+		{"valid", 5, nil},
+		{"negative radius", -1, ErrNonPositiveDimension},
+		{"zero radius", 0, ErrNonPositiveDimension},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := NewCircle(tc.radius)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("NewCircle(%v) error = %v, want %v", tc.radius, err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewCircle(%v) unexpected error: %v", tc.radius, err)
+			}
+			if c.Radius != tc.radius {
+				t.Errorf("Radius = %v, want %v", c.Radius, tc.radius)
+			}
+		})
+	}
+}
+
+func TestNewTriangle(t *testing.T) {
+	cases := []struct {
+		name    string
+		a, b, c float64
+		wantErr error
+	}{
+		{"valid", 3, 4, 5, nil},
+		{"degenerate 1-2-3", 1, 2, 3, ErrInvalidTriangle},
+		{"negative side", -1, 4, 5, ErrNonPositiveDimension},
+		{"zero side", 0, 4, 5, ErrNonPositiveDimension},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tri, err := NewTriangle(tc.a, tc.b, tc.c)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("NewTriangle(%v, %v, %v) error = %v, want %v", tc.a, tc.b, tc.c, err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewTriangle(%v, %v, %v) unexpected error: %v", tc.a, tc.b, tc.c, err)
+			}
+			if tri.A != tc.a || tri.B != tc.b || tri.C != tc.c {
+				t.Errorf("got %+v, want sides %v, %v, %v", tri, tc.a, tc.b, tc.c)
+			}
+		})
+	}
+}
+
+func TestNewRectangle(t *testing.T) {
+	cases := []struct {
+		name          string
+		width, height float64
+		wantErr       error
+	}{
+		{"valid", 6, 4, nil},
+		{"zero width", 0, 4, ErrNonPositiveDimension},
+		{"negative height", 6, -4, ErrNonPositiveDimension},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r, err := NewRectangle(tc.width, tc.height)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("NewRectangle(%v, %v) error = %v, want %v", tc.width, tc.height, err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewRectangle(%v, %v) unexpected error: %v", tc.width, tc.height, err)
+			}
+			if r.Width != tc.width || r.Height != tc.height {
+				t.Errorf("got %+v, want %v x %v", r, tc.width, tc.height)
+			}
+		})
+	}
+}
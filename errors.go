@@ -0,0 +1,15 @@
+package shapes
+
+import "errors"
+
+// ErrNonPositiveDimension is returned by constructors when a length or
+// radius is zero or negative.
+var ErrNonPositiveDimension = errors.New("shapes: dimension must be positive")
+
+// ErrInvalidTriangle is returned by NewTriangle when the given sides do
+// not satisfy the triangle inequality.
+var ErrInvalidTriangle = errors.New("shapes: sides do not form a valid triangle")
+
+// ErrTooFewSides is returned by NewRegularPolygon when sides is fewer
+// than 3, which cannot enclose an area.
+var ErrTooFewSides = errors.New("shapes: polygon must have at least 3 sides")
@@ -0,0 +1,113 @@
+package shapes
+
+import (
+	"fmt"
+	"math"
+)
+
+// Metadata is an embeddable identifier block. Shapes that embed it can
+// be tagged with an ID and a Name without affecting their Shape
+// implementation.
+type Metadata struct {
+	ID   int
+	Name string
+}
+
+type Square struct {
+	Metadata
+	Side float64
+}
+
+// NewSquare validates side and returns a Square, or
+// ErrNonPositiveDimension if side is zero or negative.
+func NewSquare(side float64) (Square, error) {
+	if side <= 0 {
+		return Square{}, fmt.Errorf("NewSquare: side %v: %w", side, ErrNonPositiveDimension)
+	}
+	return Square{Side: side}, nil
+}
+
+func (s Square) Perimeter() float64 {
+	return 4 * s.Side
+}
+
+func (s Square) Area() float64 {
+	return s.Side * s.Side
+}
+
+type Ellipse struct {
+	Metadata
+	A, B float64
+}
+
+// NewEllipse validates the semi-axes and returns an Ellipse, or
+// ErrNonPositiveDimension if either is zero or negative.
+func NewEllipse(a, b float64) (Ellipse, error) {
+	if a <= 0 || b <= 0 {
+		return Ellipse{}, fmt.Errorf("NewEllipse: a %v, b %v: %w", a, b, ErrNonPositiveDimension)
+	}
+	return Ellipse{A: a, B: b}, nil
+}
+
+func (e Ellipse) Area() float64 {
+	return math.Pi * e.A * e.B
+}
+
+// Perimeter approximates the ellipse's circumference using Ramanujan's
+// second approximation.
+func (e Ellipse) Perimeter() float64 {
+	a, b := e.A, e.B
+	return math.Pi * (3*(a+b) - math.Sqrt((3*a+b)*(a+3*b)))
+}
+
+type RegularPolygon struct {
+	Metadata
+	Sides      int
+	SideLength float64
+}
+
+// NewRegularPolygon validates sides and sideLength and returns a
+// RegularPolygon. It returns ErrTooFewSides if sides is fewer than 3
+// (a polygon needs at least 3 sides to enclose an area) or
+// ErrNonPositiveDimension if sideLength is zero or negative.
+func NewRegularPolygon(sides int, sideLength float64) (RegularPolygon, error) {
+	if sides < 3 {
+		return RegularPolygon{}, fmt.Errorf("NewRegularPolygon: sides %d: %w", sides, ErrTooFewSides)
+	}
+	if sideLength <= 0 {
+		return RegularPolygon{}, fmt.Errorf("NewRegularPolygon: sideLength %v: %w", sideLength, ErrNonPositiveDimension)
+	}
+	return RegularPolygon{Sides: sides, SideLength: sideLength}, nil
+}
+
+func (p RegularPolygon) Perimeter() float64 {
+	return float64(p.Sides) * p.SideLength
+}
+
+func (p RegularPolygon) Area() float64 {
+	n := float64(p.Sides)
+	return (n * p.SideLength * p.SideLength) / (4 * math.Tan(math.Pi/n))
+}
+
+type Trapezoid struct {
+	Metadata
+	A, B, Height, LegL, LegR float64
+}
+
+// NewTrapezoid validates the parallel sides, height, and legs and
+// returns a Trapezoid, or ErrNonPositiveDimension if any is zero or
+// negative.
+func NewTrapezoid(a, b, height, legL, legR float64) (Trapezoid, error) {
+	if a <= 0 || b <= 0 || height <= 0 || legL <= 0 || legR <= 0 {
+		return Trapezoid{}, fmt.Errorf("NewTrapezoid: a %v, b %v, height %v, legL %v, legR %v: %w", a, b, height, legL, legR, ErrNonPositiveDimension)
+	}
+	return Trapezoid{A: a, B: b, Height: height, LegL: legL, LegR: legR}, nil
+}
+
+func (t Trapezoid) Perimeter() float64 {
+	return t.A + t.B + t.LegL + t.LegR
+}
+
+func (t Trapezoid) Area() float64 {
+	return (t.A + t.B) / 2 * t.Height
+}
@@ -0,0 +1,116 @@
+package shapes
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestNewSquare(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		s, err := NewSquare(5)
+		if err != nil {
+			t.Fatalf("NewSquare(5) unexpected error: %v", err)
+		}
+		if got, want := s.Perimeter(), 20.0; got != want {
+			t.Errorf("Perimeter() = %.2f, want %.2f", got, want)
+		}
+		if got, want := s.Area(), 25.0; got != want {
+			t.Errorf("Area() = %.2f, want %.2f", got, want)
+		}
+	})
+
+	t.Run("zero side", func(t *testing.T) {
+		if _, err := NewSquare(0); !errors.Is(err, ErrNonPositiveDimension) {
+			t.Fatalf("NewSquare(0) error = %v, want %v", err, ErrNonPositiveDimension)
+		}
+	})
+}
+
+func TestEllipse(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		e, err := NewEllipse(3, 2)
+		if err != nil {
+			t.Fatalf("NewEllipse(3, 2) unexpected error: %v", err)
+		}
+		wantArea := math.Pi * 3 * 2
+		if got := e.Area(); math.Abs(got-wantArea) > 0.001 {
+			t.Errorf("Area() = %.4f, want %.4f", got, wantArea)
+		}
+		wantPerim := math.Pi * (3*(3.0+2.0) - math.Sqrt((3*3.0+2.0)*(3.0+3*2.0)))
+		if got := e.Perimeter(); math.Abs(got-wantPerim) > 0.001 {
+			t.Errorf("Perimeter() = %.4f, want %.4f", got, wantPerim)
+		}
+	})
+
+	t.Run("circle is a special case", func(t *testing.T) {
+		e, _ := NewEllipse(4, 4)
+		c := Circle{Radius: 4}
+		if math.Abs(e.Perimeter()-c.Perimeter()) > 0.001 {
+			t.Errorf("Ellipse(4,4).Perimeter() = %.4f, want %.4f", e.Perimeter(), c.Perimeter())
+		}
+	})
+
+	t.Run("negative axis", func(t *testing.T) {
+		if _, err := NewEllipse(-1, 2); !errors.Is(err, ErrNonPositiveDimension) {
+			t.Fatalf("NewEllipse(-1, 2) error = %v, want %v", err, ErrNonPositiveDimension)
+		}
+	})
+}
+
+func TestRegularPolygon(t *testing.T) {
+	t.Run("square as a 4-gon", func(t *testing.T) {
+		p, err := NewRegularPolygon(4, 5)
+		if err != nil {
+			t.Fatalf("NewRegularPolygon(4, 5) unexpected error: %v", err)
+		}
+		sq, _ := NewSquare(5)
+		if math.Abs(p.Area()-sq.Area()) > 0.001 {
+			t.Errorf("Area() = %.4f, want %.4f", p.Area(), sq.Area())
+		}
+		if got, want := p.Perimeter(), 20.0; got != want {
+			t.Errorf("Perimeter() = %.2f, want %.2f", got, want)
+		}
+	})
+
+	t.Run("too few sides", func(t *testing.T) {
+		if _, err := NewRegularPolygon(2, 5); !errors.Is(err, ErrTooFewSides) {
+			t.Fatalf("NewRegularPolygon(2, 5) error = %v, want %v", err, ErrTooFewSides)
+		}
+	})
+
+	t.Run("non-positive side length", func(t *testing.T) {
+		if _, err := NewRegularPolygon(5, 0); !errors.Is(err, ErrNonPositiveDimension) {
+			t.Fatalf("NewRegularPolygon(5, 0) error = %v, want %v", err, ErrNonPositiveDimension)
+		}
+	})
+}
+
+func TestTrapezoid(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		tz, err := NewTrapezoid(6, 4, 3, 2.5, 2.5)
+		if err != nil {
+			t.Fatalf("NewTrapezoid(...) unexpected error: %v", err)
+		}
+		if got, want := tz.Area(), 15.0; math.Abs(got-want) > 0.001 {
+			t.Errorf("Area() = %.4f, want %.4f", got, want)
+		}
+		if got, want := tz.Perimeter(), 15.0; math.Abs(got-want) > 0.001 {
+			t.Errorf("Perimeter() = %.4f, want %.4f", got, want)
+		}
+	})
+
+	t.Run("non-positive height", func(t *testing.T) {
+		if _, err := NewTrapezoid(6, 4, 0, 2.5, 2.5); !errors.Is(err, ErrNonPositiveDimension) {
+			t.Fatalf("NewTrapezoid(height=0) error = %v, want %v", err, ErrNonPositiveDimension)
+		}
+	})
+}
+
+func TestMetadataEmbedding(t *testing.T) {
+	s, _ := NewSquare(3)
+	s.Metadata = Metadata{ID: 1, Name: "unit square x3"}
+	if s.ID != 1 || s.Name != "unit square x3" {
+		t.Errorf("got %+v, want Metadata{ID: 1, Name: \"unit square x3\"}", s.Metadata)
+	}
+}
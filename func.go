@@ -1,6 +1,9 @@
 package shapes
 
-import "math"
+import (
+	"fmt"
+	"math"
+)
 
 type Shape interface {
 	Perimeter() float64
@@ -11,6 +14,15 @@ type Circle struct {
 	Radius float64
 }
 
+// NewCircle validates radius and returns a Circle, or
+// ErrNonPositiveDimension if radius is zero or negative.
+func NewCircle(radius float64) (Circle, error) {
+	if radius <= 0 {
+		return Circle{}, fmt.Errorf("NewCircle: radius %v: %w", radius, ErrNonPositiveDimension)
+	}
+	return Circle{Radius: radius}, nil
+}
+
 func (c Circle) Perimeter() float64 {
 	return 2 * math.Pi * c.Radius
 }
@@ -23,6 +35,20 @@ type Triangle struct {
 	A, B, C float64
 }
 
+// NewTriangle validates the sides and returns a Triangle. It returns
+// ErrNonPositiveDimension if any side is zero or negative, or
+// ErrInvalidTriangle if the sides fail the triangle inequality (each
+// side must be strictly less than the sum of the other two).
+func NewTriangle(a, b, c float64) (Triangle, error) {
+	if a <= 0 || b <= 0 || c <= 0 {
+		return Triangle{}, fmt.Errorf("NewTriangle: sides %v, %v, %v: %w", a, b, c, ErrNonPositiveDimension)
+	}
+	if a >= b+c || b >= a+c || c >= a+b {
+		return Triangle{}, fmt.Errorf("NewTriangle: sides %v, %v, %v: %w", a, b, c, ErrInvalidTriangle)
+	}
+	return Triangle{A: a, B: b, C: c}, nil
+}
+
 func (t Triangle) Perimeter() float64 {
 	return t.A + t.B + t.C
 }
@@ -36,6 +62,15 @@ type Rectangle struct {
 	Width, Height float64
 }
 
+// NewRectangle validates width and height and returns a Rectangle, or
+// ErrNonPositiveDimension if either is zero or negative.
+func NewRectangle(width, height float64) (Rectangle, error) {
+	if width <= 0 || height <= 0 {
+		return Rectangle{}, fmt.Errorf("NewRectangle: width %v, height %v: %w", width, height, ErrNonPositiveDimension)
+	}
+	return Rectangle{Width: width, Height: height}, nil
+}
+
 func (r Rectangle) Perimeter() float64 {
 	return 2 * (r.Width + r.Height)
 }
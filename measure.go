@@ -0,0 +1,61 @@
+package shapes
+
+// Measurable is satisfied by any concrete shape type, letting callers
+// work with homogeneous slices (e.g. []Circle) without boxing to Shape.
+type Measurable interface {
+	Perimeter() float64
+	Area() float64
+}
+
+// SumArea returns the total area of xs.
+func SumArea[T Measurable](xs []T) float64 {
+	var total float64
+	for _, x := range xs {
+		total += x.Area()
+	}
+	return total
+}
+
+// SumPerimeter returns the total perimeter of xs.
+func SumPerimeter[T Measurable](xs []T) float64 {
+	var total float64
+	for _, x := range xs {
+		total += x.Perimeter()
+	}
+	return total
+}
+
+// MaxBy returns the element of xs for which key returns the largest
+// value. It panics if xs is empty.
+func MaxBy[T Measurable](xs []T, key func(T) float64) T {
+	if len(xs) == 0 {
+		panic("shapes: MaxBy called with empty slice")
+	}
+	best := xs[0]
+	bestKey := key(best)
+	for _, x := range xs[1:] {
+		if k := key(x); k > bestKey {
+			best = x
+			bestKey = k
+		}
+	}
+	return best
+}
+
+// FilterByArea returns the elements of xs whose Area falls within
+// [min, max].
+func FilterByArea[T Measurable](xs []T, min, max float64) []T {
+	var out []T
+	for _, x := range xs {
+		if a := x.Area(); a >= min && a <= max {
+			out = append(out, x)
+		}
+	}
+	return out
+}
+
+// Measure inspects a Shape and returns its area and perimeter together,
+// for callers that only have a Shape and want both measurements at once.
+func Measure(s Shape) (area, perim float64) {
+	return s.Area(), s.Perimeter()
+}
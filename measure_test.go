@@ -0,0 +1,94 @@
+package shapes
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSumArea(t *testing.T) {
+	rects := []Rectangle{
+		{Width: 2, Height: 3},
+		{Width: 4, Height: 5},
+	}
+	got := SumArea(rects)
+	want := 6.0 + 20.0
+	if math.Abs(got-want) > 0.001 {
+		t.Errorf("SumArea() = %.4f, want %.4f", got, want)
+	}
+}
+
+func TestSumPerimeter(t *testing.T) {
+	circles := []Circle{
+		{Radius: 1},
+		{Radius: 2},
+	}
+	got := SumPerimeter(circles)
+	want := 2*math.Pi*1 + 2*math.Pi*2
+	if math.Abs(got-want) > 0.001 {
+		t.Errorf("SumPerimeter() = %.4f, want %.4f", got, want)
+	}
+}
+
+func TestMaxBy(t *testing.T) {
+	rects := []Rectangle{
+		{Width: 2, Height: 3},
+		{Width: 10, Height: 10},
+		{Width: 1, Height: 1},
+	}
+	got := MaxBy(rects, Rectangle.Area)
+	want := Rectangle{Width: 10, Height: 10}
+	if got != want {
+		t.Errorf("MaxBy() = %+v, want %+v", got, want)
+	}
+}
+
+func TestFilterByArea(t *testing.T) {
+	rects := []Rectangle{
+		{Width: 1, Height: 1},   // area 1
+		{Width: 2, Height: 2},   // area 4
+		{Width: 10, Height: 10}, // area 100
+	}
+	got := FilterByArea(rects, 2, 50)
+	if len(got) != 1 || got[0].Width != 2 {
+		t.Errorf("FilterByArea() = %+v, want only the area-4 rectangle", got)
+	}
+}
+
+func TestMeasure(t *testing.T) {
+	c := Circle{Radius: 5}
+	area, perim := Measure(c)
+	if math.Abs(area-c.Area()) > 0.001 || math.Abs(perim-c.Perimeter()) > 0.001 {
+		t.Errorf("Measure() = (%.4f, %.4f), want (%.4f, %.4f)", area, perim, c.Area(), c.Perimeter())
+	}
+}
+
+func benchmarkShapes(n int) []Shape {
+	shapes := make([]Shape, 0, n)
+	for i := 0; i < n; i++ {
+		switch i % 3 {
+		case 0:
+			shapes = append(shapes, Circle{Radius: float64(i%50 + 1)})
+		case 1:
+			shapes = append(shapes, Rectangle{Width: float64(i%50 + 1), Height: float64(i%30 + 1)})
+		default:
+			shapes = append(shapes, Triangle{A: 3, B: 4, C: 5})
+		}
+	}
+	return shapes
+}
+
+func BenchmarkSumAreaMixed(b *testing.B) {
+	mixed := benchmarkShapes(10000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		SumArea(mixed)
+	}
+}
+
+func BenchmarkSumPerimeterMixed(b *testing.B) {
+	mixed := benchmarkShapes(10000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		SumPerimeter(mixed)
+	}
+}